@@ -0,0 +1,40 @@
+package geohash
+
+// EncodeIntBatch encodes each point (lats[i], lngs[i]) to its 64-bit
+// integer geohash, writing the results into out. lats, lngs and out must
+// have the same length. This is intended for ingesting large batches of
+// GPS pings: the loop is unrolled 4 points at a time to amortize loop
+// overhead, and each point still goes through whichever architecture-
+// specific implementation EncodeInt dispatches to (see simd.go).
+func EncodeIntBatch(lats, lngs []float64, out []uint64) {
+	n := len(out)
+	i := 0
+	for ; i+4 <= n; i += 4 {
+		out[i] = EncodeInt(lats[i], lngs[i])
+		out[i+1] = EncodeInt(lats[i+1], lngs[i+1])
+		out[i+2] = EncodeInt(lats[i+2], lngs[i+2])
+		out[i+3] = EncodeInt(lats[i+3], lngs[i+3])
+	}
+	for ; i < n; i++ {
+		out[i] = EncodeInt(lats[i], lngs[i])
+	}
+}
+
+// DecodeIntBatch decodes each 64-bit integer geohash in hashes to a
+// (lat, lng) point, writing the results into lats and lngs. hashes, lats
+// and lngs must have the same length. Unrolled the same way as
+// EncodeIntBatch, and likewise benefits from whichever architecture-
+// specific deinterleave DecodeInt dispatches to (see simd.go).
+func DecodeIntBatch(hashes []uint64, lats, lngs []float64) {
+	n := len(hashes)
+	i := 0
+	for ; i+4 <= n; i += 4 {
+		lats[i], lngs[i] = DecodeInt(hashes[i])
+		lats[i+1], lngs[i+1] = DecodeInt(hashes[i+1])
+		lats[i+2], lngs[i+2] = DecodeInt(hashes[i+2])
+		lats[i+3], lngs[i+3] = DecodeInt(hashes[i+3])
+	}
+	for ; i < n; i++ {
+		lats[i], lngs[i] = DecodeInt(hashes[i])
+	}
+}
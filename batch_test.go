@@ -0,0 +1,112 @@
+package geohash
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestEncodeIntBatch(t *testing.T) {
+	lats := make([]float64, len(testcases))
+	lngs := make([]float64, len(testcases))
+	for i, c := range testcases {
+		lats[i] = c.lat
+		lngs[i] = c.lng
+	}
+
+	out := make([]uint64, len(testcases))
+	EncodeIntBatch(lats, lngs, out)
+
+	for i, c := range testcases {
+		if out[i] != c.hashInt {
+			t.Errorf("incorrect batch encode for (%v,%v): %016x != %016x", c.lat, c.lng, c.hashInt, out[i])
+		}
+	}
+}
+
+func TestDecodeIntBatch(t *testing.T) {
+	hashes := make([]uint64, len(testcases))
+	for i, c := range testcases {
+		hashes[i] = c.hashInt
+	}
+
+	lats := make([]float64, len(testcases))
+	lngs := make([]float64, len(testcases))
+	DecodeIntBatch(hashes, lats, lngs)
+
+	for i, c := range testcases {
+		elat, elng := DecodeInt(c.hashInt)
+		if lats[i] != elat || lngs[i] != elng {
+			t.Errorf("incorrect batch decode for 0x%x", c.hashInt)
+		}
+	}
+}
+
+// TestEncodeIntBatchOddLength exercises the unrolled loop in EncodeIntBatch
+// across lengths that aren't a multiple of its unroll factor, so the
+// remainder loop is covered too.
+func TestEncodeIntBatchOddLength(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 17} {
+		lats := make([]float64, n)
+		lngs := make([]float64, n)
+		for i := range lats {
+			lats[i] = r.Float64()*180 - 90
+			lngs[i] = r.Float64()*360 - 180
+		}
+
+		out := make([]uint64, n)
+		EncodeIntBatch(lats, lngs, out)
+
+		for i := range out {
+			if want := EncodeInt(lats[i], lngs[i]); out[i] != want {
+				t.Errorf("n=%d i=%d: got %016x want %016x", n, i, out[i], want)
+			}
+		}
+	}
+}
+
+// TestDecodeIntBatchOddLength is DecodeIntBatch's counterpart to
+// TestEncodeIntBatchOddLength.
+func TestDecodeIntBatchOddLength(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 17} {
+		hashes := make([]uint64, n)
+		for i := range hashes {
+			hashes[i] = r.Uint64()
+		}
+
+		lats := make([]float64, n)
+		lngs := make([]float64, n)
+		DecodeIntBatch(hashes, lats, lngs)
+
+		for i := range hashes {
+			wantLat, wantLng := DecodeInt(hashes[i])
+			if lats[i] != wantLat || lngs[i] != wantLng {
+				t.Errorf("n=%d i=%d: got (%v,%v) want (%v,%v)", n, i, lats[i], lngs[i], wantLat, wantLng)
+			}
+		}
+	}
+}
+
+func BenchmarkEncodeInt(b *testing.B) {
+	c := testcases[0]
+	for i := 0; i < b.N; i++ {
+		EncodeInt(c.lat, c.lng)
+	}
+}
+
+func BenchmarkEncodeIntBatch(b *testing.B) {
+	const n = 1024
+	lats := make([]float64, n)
+	lngs := make([]float64, n)
+	out := make([]uint64, n)
+	for i := range lats {
+		lats[i] = testcases[i%len(testcases)].lat
+		lngs[i] = testcases[i%len(testcases)].lng
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeIntBatch(lats, lngs, out)
+	}
+}
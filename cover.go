@@ -0,0 +1,185 @@
+package geohash
+
+import (
+	"math"
+	"sort"
+)
+
+// EarthRadius is the mean radius of the Earth in meters, used for haversine
+// distance calculations.
+const EarthRadius = 6371000.0
+
+// Range represents a contiguous span of 64-bit integer geohashes, inclusive
+// of both endpoints. A KV store ordered by the integer geohash (BoltDB,
+// RocksDB, DynamoDB, Bigtable, ...) can answer a "points within this region"
+// query by issuing one SCAN [Min, Max] per Range.
+type Range struct {
+	Min, Max uint64
+}
+
+// Distance returns the great-circle distance in meters between (lat1, lng1)
+// and (lat2, lng2), computed with the haversine formula.
+func Distance(lat1, lng1, lat2, lng2 float64) float64 {
+	lat1r := lat1 * math.Pi / 180
+	lat2r := lat2 * math.Pi / 180
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLng := (lng2 - lng1) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1r)*math.Cos(lat2r)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	return EarthRadius * c
+}
+
+// SuggestPrecisionForRadius returns the fewest bits of integer geohash
+// precision whose cell diagonal is at most radiusMeters/4, a reasonable
+// target for covering a circular query region without excessive fan-out.
+// If no precision below 64 bits is that small, it returns 64.
+func SuggestPrecisionForRadius(radiusMeters float64) uint {
+	target := radiusMeters / 4
+	bits := uint(1)
+	for ; bits < 64; bits++ {
+		latErr, lngErr := errorWithPrecision(bits)
+		// A flat degrees-to-meters approximation, not Distance/haversine:
+		// errorWithPrecision returns cell widths as large as 180/360 degrees
+		// at low bits, and haversine distance between two points that far
+		// apart in both axes degenerates toward 0 rather than growing, which
+		// would make this loop stop on its first iteration.
+		latMeters := latErr * math.Pi / 180 * EarthRadius
+		lngMeters := lngErr * math.Pi / 180 * EarthRadius
+		if math.Hypot(latMeters, lngMeters) <= target {
+			break
+		}
+	}
+	return bits
+}
+
+// boxForRadius returns an axis-aligned bounding box covering a circle of
+// radiusMeters around (lat, lng) on the WGS84 ellipsoid, approximated as a
+// sphere of EarthRadius. The box may extend past +/-180 longitude; callers
+// that need a box valid for lookups should split it at the antimeridian.
+func boxForRadius(lat, lng, radiusMeters float64) Box {
+	dLat := (radiusMeters / EarthRadius) * (180 / math.Pi)
+	dLng := dLat / math.Cos(lat*math.Pi/180)
+
+	minLat := lat - dLat
+	maxLat := lat + dLat
+	if minLat < -90 {
+		minLat = -90
+	}
+	if maxLat > 90 {
+		maxLat = 90
+	}
+
+	return Box{
+		MinLat: minLat,
+		MaxLat: maxLat,
+		MinLng: lng - dLng,
+		MaxLng: lng + dLng,
+	}
+}
+
+// CoverRadius returns a minimal set of contiguous integer-geohash prefix
+// ranges, each of at most `bits` precision, covering a circular query region
+// of radiusMeters around (lat, lng). The covering may include points outside
+// the circle near its edge, so callers should post-filter candidates with
+// Distance.
+func CoverRadius(lat, lng, radiusMeters float64, bits uint) []Range {
+	box := boxForRadius(lat, lng, radiusMeters)
+
+	var boxes []Box
+	switch {
+	case box.MaxLng > 180:
+		boxes = []Box{
+			{MinLat: box.MinLat, MaxLat: box.MaxLat, MinLng: box.MinLng, MaxLng: 180},
+			{MinLat: box.MinLat, MaxLat: box.MaxLat, MinLng: -180, MaxLng: box.MaxLng - 360},
+		}
+	case box.MinLng < -180:
+		boxes = []Box{
+			{MinLat: box.MinLat, MaxLat: box.MaxLat, MinLng: box.MinLng + 360, MaxLng: 180},
+			{MinLat: box.MinLat, MaxLat: box.MaxLat, MinLng: -180, MaxLng: box.MaxLng},
+		}
+	default:
+		boxes = []Box{box}
+	}
+
+	var ranges []Range
+	for _, b := range boxes {
+		ranges = append(ranges, CoverBox(b, bits)...)
+	}
+	return mergeRanges(ranges)
+}
+
+// CoverBox returns a minimal set of contiguous integer-geohash prefix ranges,
+// each of at most `bits` precision, covering the given box. It descends the
+// world quadtree implicit in the interleaved z-order encoding from the root
+// cell (hash 0, 0 bits), emitting a cell as soon as it no longer overlaps the
+// box (skipped), lies entirely inside the box, or has reached `bits` of
+// precision. Adjacent emitted cells are merged into a single Range.
+func CoverBox(box Box, bits uint) []Range {
+	var ranges []Range
+
+	var descend func(hash uint64, depth uint)
+	descend = func(hash uint64, depth uint) {
+		cell := BoundingBoxIntWithPrecision(hash, depth)
+		if !boxesOverlap(box, cell) {
+			return
+		}
+		if depth >= bits || boxContains(box, cell) {
+			ranges = append(ranges, cellRange(hash, depth))
+			return
+		}
+		descend(hash<<1, depth+1)
+		descend(hash<<1|1, depth+1)
+	}
+	descend(0, 0)
+
+	return mergeRanges(ranges)
+}
+
+// cellRange returns the inclusive [Min, Max] range of 64-bit integer
+// geohashes covered by the quadtree cell (hash, depth).
+func cellRange(hash uint64, depth uint) Range {
+	if depth == 0 {
+		return Range{Min: 0, Max: math.MaxUint64}
+	}
+	min := hash << (64 - depth)
+	max := ((hash + 1) << (64 - depth)) - 1
+	return Range{Min: min, Max: max}
+}
+
+// boxesOverlap reports whether a and b share any point.
+func boxesOverlap(a, b Box) bool {
+	return a.MinLat <= b.MaxLat && b.MinLat <= a.MaxLat &&
+		a.MinLng <= b.MaxLng && b.MinLng <= a.MaxLng
+}
+
+// boxContains reports whether outer fully contains inner.
+func boxContains(outer, inner Box) bool {
+	return outer.MinLat <= inner.MinLat && inner.MaxLat <= outer.MaxLat &&
+		outer.MinLng <= inner.MinLng && inner.MaxLng <= outer.MaxLng
+}
+
+// mergeRanges sorts ranges by their lower bound and coalesces any that are
+// contiguous or overlapping, so z-order sibling cells collapse into their
+// common parent range.
+func mergeRanges(ranges []Range) []Range {
+	if len(ranges) == 0 {
+		return ranges
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Min < ranges[j].Min })
+
+	merged := ranges[:1:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if last.Max != math.MaxUint64 && r.Min > last.Max+1 {
+			merged = append(merged, r)
+			continue
+		}
+		if r.Max > last.Max {
+			last.Max = r.Max
+		}
+	}
+	return merged
+}
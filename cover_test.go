@@ -0,0 +1,101 @@
+package geohash
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistanceZero(t *testing.T) {
+	d := Distance(51.5, -0.1, 51.5, -0.1)
+	if d != 0 {
+		t.Errorf("expected zero distance for identical points, got %v", d)
+	}
+}
+
+func TestDistanceKnownPoints(t *testing.T) {
+	// London to Paris is approximately 344km.
+	d := Distance(51.5074, -0.1278, 48.8566, 2.3522)
+	if math.Abs(d-344000) > 10000 {
+		t.Errorf("expected ~344km between London and Paris, got %vm", d)
+	}
+}
+
+func TestSuggestPrecisionForRadius(t *testing.T) {
+	bits := SuggestPrecisionForRadius(1000)
+	if bits == 0 || bits >= 64 {
+		t.Fatalf("unreasonable precision suggested: %d bits", bits)
+	}
+	latErr, lngErr := errorWithPrecision(bits)
+	latMeters := latErr * math.Pi / 180 * EarthRadius
+	lngMeters := lngErr * math.Pi / 180 * EarthRadius
+	if cell := math.Hypot(latMeters, lngMeters); cell > 1000 {
+		t.Errorf("cell size %vm exceeds requested radius 1000m", cell)
+	}
+}
+
+func TestSuggestPrecisionForRadiusScalesMonotonically(t *testing.T) {
+	radii := []float64{100, 1000, 10000, 100000, 1000000}
+	var prevBits uint
+	for i, r := range radii {
+		bits := SuggestPrecisionForRadius(r)
+		if bits == 0 || bits >= 64 {
+			t.Fatalf("unreasonable precision suggested for radius %v: %d bits", r, bits)
+		}
+		if i > 0 && bits > prevBits {
+			t.Errorf("radius %v suggested more bits (%d) than smaller radius %v (%d)", r, bits, radii[i-1], prevBits)
+		}
+		prevBits = bits
+	}
+}
+
+func TestSuggestPrecisionForRadiusBoundsCell(t *testing.T) {
+	for _, r := range []float64{50, 500, 5000, 50000, 500000} {
+		bits := SuggestPrecisionForRadius(r)
+		lat, lng := 12.5, 47.25
+		box := BoundingBoxIntWithPrecision(EncodeIntWithPrecision(lat, lng, bits), bits)
+		corner := Distance(lat, lng, box.MaxLat, box.MaxLng)
+		if corner > r {
+			t.Errorf("radius %v: cell corner is %vm from center, exceeds the query radius", r, corner)
+		}
+	}
+}
+
+func TestCoverBoxCoversCenter(t *testing.T) {
+	box := Box{MinLat: 10, MaxLat: 20, MinLng: 10, MaxLng: 20}
+	ranges := CoverBox(box, 20)
+	if len(ranges) == 0 {
+		t.Fatal("expected at least one range")
+	}
+	lat, lng := box.Center()
+	hash := EncodeIntWithPrecision(lat, lng, 20)
+	if !hashInRanges(hash, 20, ranges) {
+		t.Errorf("center of box not covered by any range")
+	}
+}
+
+func TestCoverRadiusCoversOrigin(t *testing.T) {
+	ranges := CoverRadius(37.7749, -122.4194, 5000, 24)
+	hash := EncodeIntWithPrecision(37.7749, -122.4194, 24)
+	if !hashInRanges(hash, 24, ranges) {
+		t.Errorf("center point not covered by any range")
+	}
+}
+
+func TestCoverRadiusAntimeridian(t *testing.T) {
+	ranges := CoverRadius(0, 179.999, 50000, 16)
+	if len(ranges) == 0 {
+		t.Fatal("expected ranges covering a circle that crosses the antimeridian")
+	}
+}
+
+// hashInRanges reports whether the full 64-bit geohash for a point of the
+// given precision falls inside one of the ranges.
+func hashInRanges(hash uint64, bits uint, ranges []Range) bool {
+	full := hash << (64 - bits)
+	for _, r := range ranges {
+		if full >= r.Min && full <= r.Max {
+			return true
+		}
+	}
+	return false
+}
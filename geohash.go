@@ -46,8 +46,13 @@ func EncodeWithMaxPrecision(lat, lng float64) [12]byte {
 	return enc
 }
 
-// EncodeInt encodes the point (lat, lng) to a 64-bit integer geohash.
-func EncodeInt(lat, lng float64) uint64
+// EncodeInt encodes the point (lat, lng) to a 64-bit integer geohash. On
+// architectures with an accelerated implementation (see simd.go), the fast
+// path is selected once at package init time based on detected CPU
+// features; otherwise it falls back to encodeInt.
+func EncodeInt(lat, lng float64) uint64 {
+	return encodeIntImpl(lat, lng)
+}
 
 // encodeInt provides a Go implementation of integer geohash. This is the
 // default implementation of EncodeInt, but optimized versions are provided
@@ -128,7 +133,7 @@ func BoundingBox(hash string) Box {
 // geohash with the specified precision.
 func BoundingBoxIntWithPrecision(hash uint64, bits uint) Box {
 	fullHash := hash << (64 - bits)
-	latInt, lngInt := deinterleave(fullHash)
+	latInt, lngInt := deinterleaveImpl(fullHash)
 	lat := decodeRange(latInt, 90)
 	lng := decodeRange(lngInt, 180)
 	latErr, lngErr := errorWithPrecision(bits)
@@ -0,0 +1,160 @@
+// Package geojson marshals and parses geohash points and boxes using the
+// GeoJSON format (RFC 7946), so the geohash module can be used directly from
+// HTTP handlers that speak GeoJSON without callers hand-rolling the
+// lng-first coordinate order.
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/trylugar/geohash"
+)
+
+// Geometry is a GeoJSON geometry object.
+type Geometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// Feature is a GeoJSON Feature object.
+type Feature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id,omitempty"`
+	Geometry   Geometry               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// FeatureCollection is a GeoJSON FeatureCollection object.
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+// boxRing returns the closed [lng, lat] ring describing box's perimeter.
+func boxRing(box geohash.Box) [][2]float64 {
+	return [][2]float64{
+		{box.MinLng, box.MinLat},
+		{box.MaxLng, box.MinLat},
+		{box.MaxLng, box.MaxLat},
+		{box.MinLng, box.MaxLat},
+		{box.MinLng, box.MinLat},
+	}
+}
+
+// BoxPolygon returns box as a GeoJSON Polygon geometry.
+func BoxPolygon(box geohash.Box) Geometry {
+	return Geometry{Type: "Polygon", Coordinates: [][][2]float64{boxRing(box)}}
+}
+
+// BoxFeature returns the region encoded by the string geohash as a GeoJSON
+// Feature, with hash set as both the feature's id and its "geohash"
+// property.
+func BoxFeature(hash string) Feature {
+	return Feature{
+		Type:     "Feature",
+		ID:       hash,
+		Geometry: BoxPolygon(geohash.BoundingBox(hash)),
+		Properties: map[string]interface{}{
+			"geohash": hash,
+		},
+	}
+}
+
+// PointFeature returns (lat, lng) as a GeoJSON Point Feature, tagged with
+// hash and direction properties.
+func PointFeature(lat, lng float64, hash, direction string) Feature {
+	return Feature{
+		Type:     "Feature",
+		ID:       hash,
+		Geometry: Geometry{Type: "Point", Coordinates: [2]float64{lng, lat}},
+		Properties: map[string]interface{}{
+			"geohash":   hash,
+			"direction": direction,
+		},
+	}
+}
+
+// directionNames mirrors the direction order returned by geohash.Neighbors.
+var directionNames = [8]string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+
+// NeighborsFeatureCollection returns the center point of hash together with
+// its eight neighbors as a GeoJSON FeatureCollection of Point features, each
+// tagged with a "direction" property ("center" for hash itself).
+func NeighborsFeatureCollection(hash string) FeatureCollection {
+	lat, lng := geohash.DecodeCenter(hash)
+	features := []Feature{PointFeature(lat, lng, hash, "center")}
+
+	for i, n := range geohash.Neighbors(hash) {
+		nlat, nlng := geohash.DecodeCenter(n)
+		features = append(features, PointFeature(nlat, nlng, n, directionNames[i]))
+	}
+
+	return FeatureCollection{Type: "FeatureCollection", Features: features}
+}
+
+// point is the subset of a GeoJSON Point geometry needed to recover a
+// (lat, lng) pair.
+type point struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// ParsePoint parses a GeoJSON Point geometry, returning its coordinates as
+// (lat, lng). GeoJSON coordinates are ordered [lng, lat].
+func ParsePoint(data []byte) (lat, lng float64, err error) {
+	var p point
+	if err := json.Unmarshal(data, &p); err != nil {
+		return 0, 0, err
+	}
+	if p.Type != "Point" {
+		return 0, 0, fmt.Errorf("geojson: expected Point geometry, got %q", p.Type)
+	}
+	return p.Coordinates[1], p.Coordinates[0], nil
+}
+
+// EncodePoint parses a GeoJSON Point and encodes it as a string geohash with
+// the standard 12 characters of precision.
+func EncodePoint(data []byte) (string, error) {
+	lat, lng, err := ParsePoint(data)
+	if err != nil {
+		return "", err
+	}
+	return geohash.Encode(lat, lng), nil
+}
+
+// EncodeIntPoint parses a GeoJSON Point and encodes it as a 64-bit integer
+// geohash.
+func EncodeIntPoint(data []byte) (uint64, error) {
+	lat, lng, err := ParsePoint(data)
+	if err != nil {
+		return 0, err
+	}
+	return geohash.EncodeInt(lat, lng), nil
+}
+
+// EncodeFeatureCollection streams a GeoJSON FeatureCollection of Box
+// polygons for hashes to w, encoding one Feature at a time rather than
+// buffering the whole collection in memory. This is intended for rendering
+// large coverings (e.g. from geohash.CoverBox) in map-tile debugging tools.
+func EncodeFeatureCollection(w io.Writer, hashes []string) error {
+	if _, err := io.WriteString(w, `{"type":"FeatureCollection","features":[`); err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	for i, hash := range hashes {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if err := enc.Encode(BoxFeature(hash)); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "]}")
+	return err
+}
@@ -0,0 +1,103 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/trylugar/geohash"
+)
+
+func TestBoxFeatureRoundTrip(t *testing.T) {
+	hash := geohash.Encode(37.7749, -122.4194)
+	feature := BoxFeature(hash)
+	if feature.ID != hash {
+		t.Errorf("expected feature id %q, got %q", hash, feature.ID)
+	}
+	data, err := json.Marshal(feature)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %s", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected unmarshal error: %s", err)
+	}
+	if decoded["type"] != "Feature" {
+		t.Errorf("expected type Feature, got %v", decoded["type"])
+	}
+}
+
+func TestNeighborsFeatureCollection(t *testing.T) {
+	hash := geohash.Encode(37.7749, -122.4194)
+	fc := NeighborsFeatureCollection(hash)
+	if len(fc.Features) != 9 {
+		t.Fatalf("expected 9 features (center + 8 neighbors), got %d", len(fc.Features))
+	}
+	if fc.Features[0].Properties["direction"] != "center" {
+		t.Errorf("expected first feature to be the center")
+	}
+}
+
+func TestParsePoint(t *testing.T) {
+	data := []byte(`{"type":"Point","coordinates":[-122.4194,37.7749]}`)
+	lat, lng, err := ParsePoint(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if lat != 37.7749 || lng != -122.4194 {
+		t.Errorf("incorrect lat/lng: got (%v, %v)", lat, lng)
+	}
+}
+
+func TestEncodePoint(t *testing.T) {
+	data := []byte(`{"type":"Point","coordinates":[-122.4194,37.7749]}`)
+	hash, err := EncodePoint(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if hash != geohash.Encode(37.7749, -122.4194) {
+		t.Errorf("incorrect hash: got %q", hash)
+	}
+}
+
+func TestPointWKTRoundTrip(t *testing.T) {
+	wkt := PointWKT(37.7749, -122.4194)
+	lat, lng, err := ParsePointWKT(wkt)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if math.Abs(lat-37.7749) > 1e-9 || math.Abs(lng-(-122.4194)) > 1e-9 {
+		t.Errorf("incorrect round trip: got (%v, %v)", lat, lng)
+	}
+}
+
+func TestBoxWKTRoundTrip(t *testing.T) {
+	box := geohash.Box{MinLat: 10, MaxLat: 20, MinLng: 30, MaxLng: 40}
+	wkt := BoxWKT(box)
+	got, err := ParseBoxWKT(wkt)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != box {
+		t.Errorf("incorrect round trip: got %+v, want %+v", got, box)
+	}
+}
+
+func TestEncodeFeatureCollectionStreaming(t *testing.T) {
+	hashes := []string{
+		geohash.Encode(37.7749, -122.4194),
+		geohash.Encode(40.7128, -74.0060),
+	}
+	var buf bytes.Buffer
+	if err := EncodeFeatureCollection(&buf, hashes); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var fc FeatureCollection
+	if err := json.Unmarshal(buf.Bytes(), &fc); err != nil {
+		t.Fatalf("unexpected unmarshal error: %s", err)
+	}
+	if len(fc.Features) != len(hashes) {
+		t.Fatalf("expected %d features, got %d", len(hashes), len(fc.Features))
+	}
+}
@@ -0,0 +1,98 @@
+package geojson
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/trylugar/geohash"
+)
+
+// PointWKT returns the OGC WKT representation of (lat, lng), e.g.
+// "POINT(-122.4194 37.7749)". Note the lng-first coordinate order.
+func PointWKT(lat, lng float64) string {
+	return fmt.Sprintf("POINT(%v %v)", lng, lat)
+}
+
+// ParsePointWKT parses a "POINT(lng lat)" WKT string into (lat, lng).
+func ParsePointWKT(s string) (lat, lng float64, err error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "POINT(") || !strings.HasSuffix(s, ")") {
+		return 0, 0, fmt.Errorf("geojson: malformed WKT point %q", s)
+	}
+
+	fields := strings.Fields(s[len("POINT(") : len(s)-1])
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("geojson: malformed WKT point %q", s)
+	}
+
+	lng, err = strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("geojson: malformed WKT point %q: %w", s, err)
+	}
+	lat, err = strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("geojson: malformed WKT point %q: %w", s, err)
+	}
+
+	return lat, lng, nil
+}
+
+// BoxWKT returns the OGC WKT representation of box as a closed POLYGON ring.
+func BoxWKT(box geohash.Box) string {
+	var b strings.Builder
+	b.WriteString("POLYGON((")
+	for i, p := range boxRing(box) {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%v %v", p[0], p[1])
+	}
+	b.WriteString("))")
+	return b.String()
+}
+
+// ParseBoxWKT parses a "POLYGON((lng lat, lng lat, ...))" WKT string into its
+// bounding Box.
+func ParseBoxWKT(s string) (geohash.Box, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "POLYGON((") || !strings.HasSuffix(s, "))") {
+		return geohash.Box{}, fmt.Errorf("geojson: malformed WKT polygon %q", s)
+	}
+
+	inner := s[len("POLYGON((") : len(s)-2]
+	coords := strings.Split(inner, ",")
+	if len(coords) == 0 {
+		return geohash.Box{}, fmt.Errorf("geojson: malformed WKT polygon %q", s)
+	}
+
+	box := geohash.Box{MinLat: 90, MaxLat: -90, MinLng: 180, MaxLng: -180}
+	for _, c := range coords {
+		fields := strings.Fields(c)
+		if len(fields) != 2 {
+			return geohash.Box{}, fmt.Errorf("geojson: malformed WKT polygon %q", s)
+		}
+		lng, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return geohash.Box{}, fmt.Errorf("geojson: malformed WKT polygon %q: %w", s, err)
+		}
+		lat, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return geohash.Box{}, fmt.Errorf("geojson: malformed WKT polygon %q: %w", s, err)
+		}
+		if lat < box.MinLat {
+			box.MinLat = lat
+		}
+		if lat > box.MaxLat {
+			box.MaxLat = lat
+		}
+		if lng < box.MinLng {
+			box.MinLng = lng
+		}
+		if lng > box.MaxLng {
+			box.MaxLng = lng
+		}
+	}
+
+	return box, nil
+}
@@ -0,0 +1,142 @@
+package geohash
+
+// EncodeIntHilbert encodes the point (lat, lng) to a 64-bit integer geohash
+// using a Hilbert-curve ordering instead of the Morton (z-order) interleave
+// used by EncodeInt. The Hilbert curve preserves spatial locality strictly
+// better than z-order: adjacent integers are always spatially adjacent,
+// which reduces the false-positive rate of range-scan queries near quadrant
+// boundaries. This is the same trade-off Uber's H3 and Google's S2 make.
+// Callers pick Morton for geohash-string compatibility, or Hilbert for
+// better range-scan selectivity.
+func EncodeIntHilbert(lat, lng float64) uint64 {
+	latInt := encodeRange(lat, 90)
+	lngInt := encodeRange(lng, 180)
+	return hilbertXY2D(latInt, lngInt)
+}
+
+// EncodeIntHilbertWithPrecision encodes the point (lat, lng) to a
+// Hilbert-curve integer with the specified number of bits.
+func EncodeIntHilbertWithPrecision(lat, lng float64, bits uint) uint64 {
+	hash := EncodeIntHilbert(lat, lng)
+	return hash >> (64 - bits)
+}
+
+// DecodeIntHilbert decodes a 64-bit Hilbert-curve integer geohash to a
+// (lat, lng) point.
+func DecodeIntHilbert(hash uint64) (lat, lng float64) {
+	box := BoundingBoxIntHilbertWithPrecision(hash, 64)
+	return box.Round()
+}
+
+// BoundingBoxIntHilbertWithPrecision returns the region encoded by the
+// Hilbert-curve integer geohash with the specified precision. Unlike the
+// Morton encoding, each level of the Hilbert curve mixes one bit of
+// latitude with one bit of longitude to pick among its four quadrants, so
+// precision only has meaning in 2-bit (one quadtree level) increments; odd
+// bit counts are rounded down to the nearest even value.
+func BoundingBoxIntHilbertWithPrecision(hash uint64, bits uint) Box {
+	depth := bits / 2
+	levelBits := depth * 2
+
+	fullHash := hash << (64 - bits)
+	latInt, lngInt := hilbertD2XY(fullHash)
+	mask := ^uint32(0) << (32 - depth)
+	latInt &= mask
+	lngInt &= mask
+
+	lat := decodeRange(latInt, 90)
+	lng := decodeRange(lngInt, 180)
+	latErr, lngErr := errorWithPrecision(levelBits)
+	return Box{
+		MinLat: lat,
+		MaxLat: lat + latErr,
+		MinLng: lng,
+		MaxLng: lng + lngErr,
+	}
+}
+
+// NeighborsIntHilbert returns a slice of uint64s that correspond to the
+// provided Hilbert-curve hash's neighbors at 64-bit precision.
+func NeighborsIntHilbert(hash uint64) []uint64 {
+	return NeighborsIntHilbertWithPrecision(hash, 64)
+}
+
+// NeighborsIntHilbertWithPrecision returns a slice of uint64s that
+// correspond to the provided Hilbert-curve hash's neighbors at the given
+// precision.
+func NeighborsIntHilbertWithPrecision(hash uint64, bits uint) []uint64 {
+	box := BoundingBoxIntHilbertWithPrecision(hash, bits)
+	lat, lng := box.Center()
+	latDelta := box.MaxLat - box.MinLat
+	lngDelta := box.MaxLng - box.MinLng
+	return []uint64{
+		// N
+		EncodeIntHilbertWithPrecision(lat+latDelta, lng, bits),
+		// NE,
+		EncodeIntHilbertWithPrecision(lat+latDelta, lng+lngDelta, bits),
+		// E,
+		EncodeIntHilbertWithPrecision(lat, lng+lngDelta, bits),
+		// SE,
+		EncodeIntHilbertWithPrecision(lat-latDelta, lng+lngDelta, bits),
+		// S,
+		EncodeIntHilbertWithPrecision(lat-latDelta, lng, bits),
+		// SW,
+		EncodeIntHilbertWithPrecision(lat-latDelta, lng-lngDelta, bits),
+		// W,
+		EncodeIntHilbertWithPrecision(lat, lng-lngDelta, bits),
+		// NW
+		EncodeIntHilbertWithPrecision(lat+latDelta, lng-lngDelta, bits),
+	}
+}
+
+// hilbertOrder is the number of bits in each of the x and y coordinates fed
+// to the Hilbert curve conversion, matching the 32-bit range encoding used
+// by encodeRange/decodeRange.
+const hilbertOrder = 32
+
+// hilbertXY2D converts (x, y) coordinates on a 2^hilbertOrder square grid to
+// their position d along the Hilbert curve.
+func hilbertXY2D(x, y uint32) uint64 {
+	var d uint64
+	for s := uint32(1) << (hilbertOrder - 1); s > 0; s >>= 1 {
+		var rx, ry uint32
+		if x&s > 0 {
+			rx = 1
+		}
+		if y&s > 0 {
+			ry = 1
+		}
+		d += uint64(s) * uint64(s) * uint64((3*rx)^ry)
+		x, y = hilbertRotate(s, x, y, rx, ry)
+	}
+	return d
+}
+
+// hilbertD2XY is the inverse of hilbertXY2D: it converts a position d along
+// the Hilbert curve back to (x, y) coordinates on the 2^hilbertOrder square
+// grid.
+func hilbertD2XY(d uint64) (x, y uint32) {
+	t := d
+	for s := uint64(1); s < uint64(1)<<hilbertOrder; s <<= 1 {
+		rx := uint32(1 & (t / 2))
+		ry := uint32(1 & (t ^ uint64(rx)))
+		x, y = hilbertRotate(uint32(s), x, y, rx, ry)
+		x += uint32(s) * rx
+		y += uint32(s) * ry
+		t /= 4
+	}
+	return x, y
+}
+
+// hilbertRotate applies the quadrant rotation/reflection used when
+// descending (or ascending) the Hilbert curve.
+func hilbertRotate(s, x, y, rx, ry uint32) (uint32, uint32) {
+	if ry == 0 {
+		if rx == 1 {
+			x = s - 1 - x
+			y = s - 1 - y
+		}
+		x, y = y, x
+	}
+	return x, y
+}
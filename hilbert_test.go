@@ -0,0 +1,44 @@
+package geohash
+
+import (
+	"math"
+	"testing"
+)
+
+// Test Hilbert encode/decode round trip stays close to the original point.
+func TestEncodeDecodeIntHilbert(t *testing.T) {
+	for _, c := range testcases {
+		hash := EncodeIntHilbert(c.lat, c.lng)
+		lat, lng := DecodeIntHilbert(hash)
+		if math.Abs(lat-c.lat) > 0.0000001 {
+			t.Errorf("large error in decoded latitude for 0x%x", hash)
+		}
+		if math.Abs(lng-c.lng) > 0.0000001 {
+			t.Errorf("large error in decoded longitude for 0x%x", hash)
+		}
+	}
+}
+
+// Test bounding boxes for Hilbert integer geohashes contain the original
+// point.
+func TestBoundingBoxIntHilbertWithPrecision(t *testing.T) {
+	for _, c := range testcases {
+		for bits := uint(2); bits <= 64; bits += 2 {
+			hash := EncodeIntHilbertWithPrecision(c.lat, c.lng, bits)
+			box := BoundingBoxIntHilbertWithPrecision(hash, bits)
+			if !box.Contains(c.lat, c.lng) {
+				t.Errorf("incorrect Hilbert bounding box for (%v,%v) at %d bits", c.lat, c.lng, bits)
+			}
+		}
+	}
+}
+
+func TestNeighborsIntHilbert(t *testing.T) {
+	for _, c := range testcases {
+		hash := EncodeIntHilbert(c.lat, c.lng)
+		neighbors := NeighborsIntHilbert(hash)
+		if len(neighbors) != 8 {
+			t.Fatalf("expected 8 neighbors, got %d", len(neighbors))
+		}
+	}
+}
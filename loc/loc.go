@@ -0,0 +1,115 @@
+// Package loc encodes and parses RFC 1876 DNS LOC textual records for
+// geohash points, so geohash-tagged locations can be published or consumed
+// through DNS-based location zone files.
+package loc
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/trylugar/geohash"
+)
+
+// EncodeLOC formats (lat, lng, altMeters) together with the location's
+// size and horizontal/vertical precision (all in meters) as an RFC 1876
+// LOC textual record, e.g. "37 25 44.500 N 122 5 40.500 W 25m 10m 10m 10m".
+func EncodeLOC(lat, lng, altMeters, sizeMeters, hpMeters, vpMeters float64) string {
+	return fmt.Sprintf("%s %s %gm %gm %gm %gm",
+		formatDMS(lat, "N", "S"),
+		formatDMS(lng, "E", "W"),
+		altMeters, sizeMeters, hpMeters, vpMeters)
+}
+
+// formatDMS formats a decimal degree value as "D M S.sss HEMI", where HEMI
+// is positive for non-negative values and negative otherwise.
+func formatDMS(decimal float64, positive, negative string) string {
+	hemi := positive
+	if decimal < 0 {
+		hemi = negative
+	}
+
+	abs := math.Abs(decimal)
+	deg := int(abs)
+	minFloat := (abs - float64(deg)) * 60
+	min := int(minFloat)
+	sec := (minFloat - float64(min)) * 60
+
+	// Round to the precision %.3f prints, then carry into minutes and
+	// degrees: the truncated min/deg above can leave a sec that rounds up to
+	// 60.000, which would otherwise be printed verbatim as an out-of-range
+	// seconds field.
+	sec = math.Round(sec*1000) / 1000
+	if sec >= 60 {
+		sec -= 60
+		min++
+	}
+	if min >= 60 {
+		min -= 60
+		deg++
+	}
+
+	return fmt.Sprintf("%d %d %.3f %s", deg, min, sec, hemi)
+}
+
+// ParseLOC parses an RFC 1876 LOC textual record of the form produced by
+// EncodeLOC into (lat, lng, altMeters). The size and precision fields are
+// accepted but not returned. Trailing "m" suffixes on the altitude, size
+// and precision fields are decorative and optional.
+func ParseLOC(s string) (lat, lng, alt float64, err error) {
+	fields := strings.Fields(s)
+	if len(fields) < 9 {
+		return 0, 0, 0, fmt.Errorf("loc: malformed record %q", s)
+	}
+
+	lat, err = parseDMS(fields[0], fields[1], fields[2], fields[3], "N", "S")
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("loc: malformed record %q: %w", s, err)
+	}
+	lng, err = parseDMS(fields[4], fields[5], fields[6], fields[7], "E", "W")
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("loc: malformed record %q: %w", s, err)
+	}
+	alt, err = strconv.ParseFloat(strings.TrimSuffix(fields[8], "m"), 64)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("loc: malformed altitude %q: %w", fields[8], err)
+	}
+
+	return lat, lng, alt, nil
+}
+
+// parseDMS parses a "D M S HEMI" quadruple into a signed decimal degree
+// value.
+func parseDMS(degStr, minStr, secStr, hemi, positive, negative string) (float64, error) {
+	deg, err := strconv.Atoi(degStr)
+	if err != nil {
+		return 0, err
+	}
+	min, err := strconv.Atoi(minStr)
+	if err != nil {
+		return 0, err
+	}
+	sec, err := strconv.ParseFloat(secStr, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	decimal := float64(deg) + float64(min)/60 + sec/3600
+	switch hemi {
+	case positive:
+	case negative:
+		decimal = -decimal
+	default:
+		return 0, fmt.Errorf("unexpected hemisphere %q", hemi)
+	}
+	return decimal, nil
+}
+
+// BoxToLOC returns box's center as an RFC 1876 LOC record, using the
+// diagonal of box, in meters, as the LOC size and precision fields.
+func BoxToLOC(b geohash.Box) string {
+	lat, lng := b.Center()
+	diagonal := geohash.Distance(b.MinLat, b.MinLng, b.MaxLat, b.MaxLng)
+	return EncodeLOC(lat, lng, 0, diagonal, diagonal, diagonal)
+}
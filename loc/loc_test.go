@@ -0,0 +1,93 @@
+package loc
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/trylugar/geohash"
+)
+
+func TestEncodeLOC(t *testing.T) {
+	got := EncodeLOC(37.0, -122.0, 25, 10, 10, 10)
+	want := "37 0 0.000 N 122 0 0.000 W 25m 10m 10m 10m"
+	if got != want {
+		t.Errorf("incorrect LOC record: got %q want %q", got, want)
+	}
+}
+
+func TestEncodeLOCSecondsCarry(t *testing.T) {
+	got := EncodeLOC(31.483333320116387, 0, 25, 10, 10, 10)
+	want := "31 29 0.000 N 0 0 0.000 E 25m 10m 10m 10m"
+	if got != want {
+		t.Errorf("incorrect LOC record: got %q want %q", got, want)
+	}
+}
+
+func TestEncodeLOCSecondsNeverReach60(t *testing.T) {
+	for i := 0; i < 2_000_000; i++ {
+		lat := float64(i%180) + float64(i%1000)/1000
+		record := EncodeLOC(lat, 0, 0, 0, 0, 0)
+		fields := strings.Fields(record)
+		min, _ := strconv.Atoi(fields[1])
+		sec, _ := strconv.ParseFloat(fields[2], 64)
+		if sec >= 60 {
+			t.Fatalf("lat %v: seconds field %v did not carry into minutes: %q", lat, sec, record)
+		}
+		if min >= 60 {
+			t.Fatalf("lat %v: minutes field %v did not carry into degrees: %q", lat, min, record)
+		}
+	}
+}
+
+func TestParseLOCRoundTrip(t *testing.T) {
+	lat, lng, alt := 37.428748, -122.071205, 25.0
+	record := EncodeLOC(lat, lng, alt, 10, 10, 10)
+
+	gotLat, gotLng, gotAlt, err := ParseLOC(record)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if math.Abs(gotLat-lat) > 0.0001 {
+		t.Errorf("incorrect latitude: got %v want %v", gotLat, lat)
+	}
+	if math.Abs(gotLng-lng) > 0.0001 {
+		t.Errorf("incorrect longitude: got %v want %v", gotLng, lng)
+	}
+	if gotAlt != alt {
+		t.Errorf("incorrect altitude: got %v want %v", gotAlt, alt)
+	}
+}
+
+func TestParseLOCSouthernEasternHemispheres(t *testing.T) {
+	record := "33 51 35.900 S 151 12 40.000 E 25m 10m 10m 10m"
+	lat, lng, _, err := ParseLOC(record)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if lat >= 0 {
+		t.Errorf("expected negative latitude, got %v", lat)
+	}
+	if lng <= 0 {
+		t.Errorf("expected positive longitude, got %v", lng)
+	}
+}
+
+func TestParseLOCMalformed(t *testing.T) {
+	if _, _, _, err := ParseLOC("not a loc record"); err == nil {
+		t.Error("expected an error for a malformed record")
+	}
+}
+
+func TestBoxToLOC(t *testing.T) {
+	box := geohash.BoundingBox("9q8yyk")
+	record := BoxToLOC(box)
+	lat, lng, _, err := ParseLOC(record)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !box.Contains(lat, lng) {
+		t.Errorf("LOC center %v,%v not contained in box %+v", lat, lng, box)
+	}
+}
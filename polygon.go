@@ -0,0 +1,254 @@
+package geohash
+
+import "math"
+
+// CoverPolygon returns a minimal set of contiguous integer-geohash prefix
+// ranges, each of at most bits precision, covering the simple polygon
+// described by ring. Ring points are (lat, lng) pairs, matching Box's field
+// order (not GeoJSON's lng-first order). The covering may include points
+// outside the polygon near its edges; callers should post-filter candidates
+// with PolygonContainsHash.
+//
+// CoverPolygon does not clip polygons that cross the antimeridian; split
+// such a ring into two rings at +/-180 longitude and cover each with its
+// own CoverPolygon call, as CoverRadius does internally for circles.
+func CoverPolygon(ring [][2]float64, bits uint) []Range {
+	return CoverMultiPolygon([][][2]float64{ring}, bits)
+}
+
+// CoverMultiPolygon is CoverPolygon generalized to a polygon with holes:
+// rings[0] is the exterior ring and rings[1:] are holes, combined using the
+// even-odd rule.
+func CoverMultiPolygon(rings [][][2]float64, bits uint) []Range {
+	bbox := ringsBoundingBox(rings)
+
+	var ranges []Range
+	var descend func(hash uint64, depth uint)
+	descend = func(hash uint64, depth uint) {
+		cell := BoundingBoxIntWithPrecision(hash, depth)
+
+		switch classifyBoxAgainstPolygon(cell, rings, bbox) {
+		case polygonOutside:
+			return
+		case polygonInside:
+			ranges = append(ranges, cellRange(hash, depth))
+			return
+		}
+
+		if depth >= bits {
+			ranges = append(ranges, cellRange(hash, depth))
+			return
+		}
+		descend(hash<<1, depth+1)
+		descend(hash<<1|1, depth+1)
+	}
+	descend(0, 0)
+
+	return mergeRanges(ranges)
+}
+
+// PolygonContainsHash reports whether the point encoded by the integer
+// geohash hash, at the given precision, lies inside the polygon described
+// by ring, for exact post-filtering of a CoverPolygon/CoverMultiPolygon
+// result. Only the single ring is tested; holes are not taken into account.
+func PolygonContainsHash(ring [][2]float64, hash uint64, bits uint) bool {
+	lat, lng := DecodeIntWithPrecision(hash, bits)
+	return pointInRing(lat, lng, ring)
+}
+
+// polygonClass is the result of comparing a quadtree cell against a
+// polygon.
+type polygonClass int
+
+const (
+	polygonOutside polygonClass = iota
+	polygonInside
+	polygonPartial
+)
+
+// classifyBoxAgainstPolygon classifies box's relationship to the polygon
+// described by rings (exterior ring followed by any holes).
+func classifyBoxAgainstPolygon(box Box, rings [][][2]float64, bbox Box) polygonClass {
+	if !boxesOverlap(box, bbox) {
+		return polygonOutside
+	}
+
+	corners := [4][2]float64{
+		{box.MinLat, box.MinLng},
+		{box.MinLat, box.MaxLng},
+		{box.MaxLat, box.MaxLng},
+		{box.MaxLat, box.MinLng},
+	}
+
+	allIn, anyIn := true, false
+	for _, c := range corners {
+		if polygonContains(c[0], c[1], rings) {
+			anyIn = true
+		} else {
+			allIn = false
+		}
+	}
+
+	if boxIntersectsRings(box, rings) {
+		return polygonPartial
+	}
+	if allIn {
+		// A hole ring can lie entirely within box without any of its points
+		// crossing box's edges or poking a corner outside it (e.g. box
+		// strictly contains the hole). Such a cell isn't fully inside the
+		// polygon: its interior around the hole must still be excluded, so
+		// descend further instead of emitting the whole cell.
+		for _, hole := range rings[1:] {
+			if ringEntirelyInBox(hole, box) {
+				return polygonPartial
+			}
+		}
+		return polygonInside
+	}
+	if anyIn {
+		return polygonPartial
+	}
+	// No box corner is inside the polygon and no edge crosses the box, so
+	// either the box and polygon are disjoint, or the polygon is a small
+	// island entirely within this cell.
+	for _, ring := range rings {
+		for _, p := range ring {
+			if box.Contains(p[0], p[1]) {
+				return polygonPartial
+			}
+		}
+	}
+	return polygonOutside
+}
+
+// polygonContains reports whether (lat, lng) is inside the polygon
+// described by rings (exterior ring followed by any holes), using the
+// even-odd rule: containment in each ring toggles the result, so holes
+// subtract from the exterior ring.
+func polygonContains(lat, lng float64, rings [][][2]float64) bool {
+	inside := false
+	for _, ring := range rings {
+		if pointInRing(lat, lng, ring) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// pointInRing reports whether (lat, lng) is inside ring using the standard
+// ray-casting algorithm.
+func pointInRing(lat, lng float64, ring [][2]float64) bool {
+	inside := false
+	n := len(ring)
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		yi, xi := ring[i][0], ring[i][1]
+		yj, xj := ring[j][0], ring[j][1]
+		if (yi > lat) != (yj > lat) &&
+			lng < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// ringsBoundingBox returns the bounding Box of every point across rings.
+func ringsBoundingBox(rings [][][2]float64) Box {
+	box := Box{MinLat: 90, MaxLat: -90, MinLng: 180, MaxLng: -180}
+	for _, ring := range rings {
+		for _, p := range ring {
+			if p[0] < box.MinLat {
+				box.MinLat = p[0]
+			}
+			if p[0] > box.MaxLat {
+				box.MaxLat = p[0]
+			}
+			if p[1] < box.MinLng {
+				box.MinLng = p[1]
+			}
+			if p[1] > box.MaxLng {
+				box.MaxLng = p[1]
+			}
+		}
+	}
+	return box
+}
+
+// ringEntirelyInBox reports whether every point of ring lies within box.
+func ringEntirelyInBox(ring [][2]float64, box Box) bool {
+	for _, p := range ring {
+		if !box.Contains(p[0], p[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// boxIntersectsRings reports whether any edge of box intersects any edge of
+// any ring.
+func boxIntersectsRings(box Box, rings [][][2]float64) bool {
+	edges := boxEdges(box)
+	for _, ring := range rings {
+		n := len(ring)
+		for i := 0; i < n; i++ {
+			j := (i + 1) % n
+			for _, e := range edges {
+				if segmentsIntersect(ring[i], ring[j], e[0], e[1]) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// boxEdges returns the four edges of box's perimeter as (lat, lng) point
+// pairs.
+func boxEdges(box Box) [4][2][2]float64 {
+	a := [2]float64{box.MinLat, box.MinLng}
+	b := [2]float64{box.MinLat, box.MaxLng}
+	c := [2]float64{box.MaxLat, box.MaxLng}
+	d := [2]float64{box.MaxLat, box.MinLng}
+	return [4][2][2]float64{{a, b}, {b, c}, {c, d}, {d, a}}
+}
+
+// segmentsIntersect reports whether segment p1-p2 intersects segment p3-p4,
+// including shared endpoints and collinear overlap.
+func segmentsIntersect(p1, p2, p3, p4 [2]float64) bool {
+	d1 := orientation(p3, p4, p1)
+	d2 := orientation(p3, p4, p2)
+	d3 := orientation(p1, p2, p3)
+	d4 := orientation(p1, p2, p4)
+
+	if ((d1 > 0) != (d2 > 0)) && d1 != 0 && d2 != 0 &&
+		((d3 > 0) != (d4 > 0)) && d3 != 0 && d4 != 0 {
+		return true
+	}
+
+	if d1 == 0 && onSegment(p3, p4, p1) {
+		return true
+	}
+	if d2 == 0 && onSegment(p3, p4, p2) {
+		return true
+	}
+	if d3 == 0 && onSegment(p1, p2, p3) {
+		return true
+	}
+	if d4 == 0 && onSegment(p1, p2, p4) {
+		return true
+	}
+	return false
+}
+
+// orientation returns the signed area of the triangle (a, b, c): positive
+// if a->b->c turns counter-clockwise, negative if clockwise, zero if
+// collinear.
+func orientation(a, b, c [2]float64) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (c[0]-a[0])*(b[1]-a[1])
+}
+
+// onSegment reports whether p lies on the bounding box of segment a-b,
+// given that a, b and p are already known to be collinear.
+func onSegment(a, b, p [2]float64) bool {
+	return math.Min(a[0], b[0]) <= p[0] && p[0] <= math.Max(a[0], b[0]) &&
+		math.Min(a[1], b[1]) <= p[1] && p[1] <= math.Max(a[1], b[1])
+}
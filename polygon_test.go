@@ -0,0 +1,79 @@
+package geohash
+
+import "testing"
+
+func squareRing(minLat, maxLat, minLng, maxLng float64) [][2]float64 {
+	return [][2]float64{
+		{minLat, minLng},
+		{minLat, maxLng},
+		{maxLat, maxLng},
+		{maxLat, minLng},
+	}
+}
+
+func TestPointInRing(t *testing.T) {
+	ring := squareRing(10, 20, 10, 20)
+	if !pointInRing(15, 15, ring) {
+		t.Error("expected center point to be inside the ring")
+	}
+	if pointInRing(5, 5, ring) {
+		t.Error("expected point outside the ring to be reported as outside")
+	}
+}
+
+func TestCoverPolygonCoversCenter(t *testing.T) {
+	ring := squareRing(10, 20, 10, 20)
+	ranges := CoverPolygon(ring, 20)
+	if len(ranges) == 0 {
+		t.Fatal("expected at least one range")
+	}
+	hash := EncodeIntWithPrecision(15, 15, 20)
+	if !hashInRanges(hash, 20, ranges) {
+		t.Error("center of polygon not covered by any range")
+	}
+}
+
+func TestCoverPolygonExcludesFarPoint(t *testing.T) {
+	ring := squareRing(10, 20, 10, 20)
+	ranges := CoverPolygon(ring, 20)
+	hash := EncodeIntWithPrecision(60, 60, 20)
+	if hashInRanges(hash, 20, ranges) {
+		t.Error("distant point unexpectedly covered")
+	}
+}
+
+func TestPolygonContainsHash(t *testing.T) {
+	ring := squareRing(10, 20, 10, 20)
+	hash := EncodeIntWithPrecision(15, 15, 24)
+	if !PolygonContainsHash(ring, hash, 24) {
+		t.Error("expected hash inside the polygon to be reported as contained")
+	}
+
+	hash = EncodeIntWithPrecision(60, 60, 24)
+	if PolygonContainsHash(ring, hash, 24) {
+		t.Error("expected hash outside the polygon to be reported as not contained")
+	}
+}
+
+func TestCoverMultiPolygonWithHole(t *testing.T) {
+	exterior := squareRing(0, 30, 0, 30)
+	hole := squareRing(10, 20, 10, 20)
+	ranges := CoverMultiPolygon([][][2]float64{exterior, hole}, 20)
+
+	outsideHole := EncodeIntWithPrecision(5, 5, 20)
+	if !hashInRanges(outsideHole, 20, ranges) {
+		t.Error("point inside the exterior ring but outside the hole should be covered")
+	}
+
+	insideHole := EncodeIntWithPrecision(15, 15, 20)
+	if hashInRanges(insideHole, 20, ranges) {
+		t.Error("point inside the hole should not be covered by any range")
+	}
+
+	if !polygonContains(5, 5, [][][2]float64{exterior, hole}) {
+		t.Error("point outside the hole should be considered inside the polygon-with-hole")
+	}
+	if polygonContains(15, 15, [][][2]float64{exterior, hole}) {
+		t.Error("point inside the hole should be considered outside the polygon-with-hole")
+	}
+}
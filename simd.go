@@ -0,0 +1,19 @@
+package geohash
+
+// encodeIntImpl and deinterleaveImpl back EncodeInt and
+// BoundingBoxIntWithPrecision (and so DecodeInt) respectively. Both default
+// to the portable Go implementation and are swapped for an accelerated one
+// in wireSIMD, based on CPU features detected at package init time.
+// Resolving the fast path once at init, rather than checking CPU features
+// on every call, keeps the hot path branch-free. EncodeIntBatch and
+// DecodeIntBatch have no batch-specific acceleration of their own; they go
+// through this same dispatch by calling EncodeInt/DecodeInt per point, 4 at
+// a time to amortize loop overhead.
+var (
+	encodeIntImpl    = encodeInt
+	deinterleaveImpl = deinterleave
+)
+
+func init() {
+	wireSIMD()
+}
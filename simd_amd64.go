@@ -0,0 +1,27 @@
+//go:build amd64
+
+package geohash
+
+import "golang.org/x/sys/cpu"
+
+// wireSIMD selects the BMI2 implementations of EncodeInt and
+// BoundingBoxIntWithPrecision's deinterleave step when the host CPU
+// supports it.
+func wireSIMD() {
+	if cpu.X86.HasBMI2 {
+		encodeIntImpl = encodeIntBMI2
+		deinterleaveImpl = deinterleaveBMI2
+	}
+}
+
+// encodeIntBMI2 is implemented in simd_amd64.s. It computes the same result
+// as encodeInt, but spreads each 32-bit coordinate into 64 bits with a
+// single PDEP instruction instead of the five-step shift-and-mask sequence
+// in spread.
+func encodeIntBMI2(lat, lng float64) uint64
+
+// deinterleaveBMI2 is implemented in simd_amd64.s. It computes the same
+// result as deinterleave, but extracts each 32-bit coordinate with a single
+// PEXT instruction instead of the five-step shift-and-mask sequence in
+// squash.
+func deinterleaveBMI2(hash uint64) (latInt, lngInt uint32)
@@ -0,0 +1,10 @@
+//go:build arm64
+
+package geohash
+
+// wireSIMD is a no-op on arm64 today. Unlike amd64's BMI2 PDEP/PEXT, ARM64
+// has no single instruction for a bit-level interleave, so there is no
+// scalar accelerator for EncodeInt to dispatch to here, and no NEON batch
+// path either; EncodeInt and the batch APIs use the portable Go fallbacks
+// on this architecture.
+func wireSIMD() {}
@@ -0,0 +1,8 @@
+//go:build !amd64 && !arm64
+
+package geohash
+
+// wireSIMD is a no-op on architectures without a dedicated accelerated
+// implementation; EncodeInt and the batch APIs use the portable Go
+// fallbacks.
+func wireSIMD() {}